@@ -0,0 +1,108 @@
+package main
+
+// NodeType identifies the concrete kind of an AST node.
+type NodeType int
+
+const (
+	VariableDeclarationNode NodeType = iota
+	FunctionDeclarationNode
+	LiteralNode
+	IdentifierNode
+	BinaryExpressionNode
+	ImplicitCastNode
+)
+
+// Node is implemented by every AST node.
+type Node interface {
+	NodeType() NodeType
+	Position() (line, col int)
+}
+
+// Statement is implemented by AST nodes that appear in statement position.
+type Statement interface {
+	Node
+	statementNode()
+}
+
+// Expression is implemented by AST nodes that produce a value.
+type Expression interface {
+	Node
+	expressionNode()
+}
+
+// BaseNode carries the metadata shared by every concrete AST node.
+type BaseNode struct {
+	Type NodeType
+	Line int
+	Col  int
+}
+
+func (b BaseNode) NodeType() NodeType { return b.Type }
+
+func (b BaseNode) Position() (int, int) { return b.Line, b.Col }
+
+// VariableDeclaration is a `let`/`var`-style binding, with an optional
+// type annotation and/or initializer.
+type VariableDeclaration struct {
+	BaseNode
+	Identifier  string
+	DataType    string
+	Initializer Expression
+	Mutable     bool
+}
+
+func (*VariableDeclaration) statementNode() {}
+
+// Parameter is a single formal parameter of a function declaration.
+type Parameter struct {
+	Name     string
+	DataType string
+}
+
+// FunctionDeclaration declares a named function.
+type FunctionDeclaration struct {
+	BaseNode
+	Name       string
+	Parameters []Parameter
+	ReturnType string
+	Body       []Statement
+}
+
+func (*FunctionDeclaration) statementNode() {}
+
+// Literal is a constant value written directly in source.
+type Literal struct {
+	BaseNode
+	Value interface{}
+}
+
+func (*Literal) expressionNode() {}
+
+// Identifier references a previously declared variable or function.
+type Identifier struct {
+	BaseNode
+	Name string
+}
+
+func (*Identifier) expressionNode() {}
+
+// BinaryExpression applies an infix operator to two operands.
+type BinaryExpression struct {
+	BaseNode
+	Operator string
+	Left     Expression
+	Right    Expression
+}
+
+func (*BinaryExpression) expressionNode() {}
+
+// ImplicitCast wraps an expression that the checker promoted to a wider
+// type (currently only int -> float), so a later evaluator or codegen
+// pass sees the coercion instead of re-deriving it.
+type ImplicitCast struct {
+	BaseNode
+	Expr       Expression
+	TargetType Type
+}
+
+func (*ImplicitCast) expressionNode() {}
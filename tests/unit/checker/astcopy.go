@@ -0,0 +1,164 @@
+package main
+
+import "fmt"
+
+// Clone produces a deep copy of an AST node, so passes like constant
+// folding, macro expansion, or generic instantiation can rewrite a copy
+// without mutating the tree other code still holds a reference to.
+// Immutable leaves (a Literal's scalar Value, a Parameter's strings)
+// are shared rather than copied, since nothing can mutate them through
+// either tree.
+func Clone[T Node](n T) T {
+	cloned, err := clone(Node(n))
+	if err != nil {
+		panic(fmt.Sprintf("astcopy: %v", err))
+	}
+	if cloned == nil {
+		var zero T
+		return zero
+	}
+	return cloned.(T)
+}
+
+// clone is the single switch over every concrete AST type. It is kept
+// separate from the generic Clone wrapper because type switches over
+// type parameters are not yet supported by the language.
+func clone(n any) (any, error) {
+	switch v := n.(type) {
+	case nil:
+		return nil, nil
+	case *VariableDeclaration:
+		if v == nil {
+			return v, nil
+		}
+		return cloneVariableDeclaration(v)
+	case *FunctionDeclaration:
+		if v == nil {
+			return v, nil
+		}
+		return cloneFunctionDeclaration(v)
+	case *Literal:
+		if v == nil {
+			return v, nil
+		}
+		return cloneLiteral(v), nil
+	case *Identifier:
+		if v == nil {
+			return v, nil
+		}
+		return cloneIdentifier(v), nil
+	case *BinaryExpression:
+		if v == nil {
+			return v, nil
+		}
+		return cloneBinaryExpression(v)
+	case *ImplicitCast:
+		if v == nil {
+			return v, nil
+		}
+		return cloneImplicitCast(v)
+	default:
+		return nil, fmt.Errorf("unsupported node type %T", n)
+	}
+}
+
+func cloneExpression(e Expression) (Expression, error) {
+	if e == nil {
+		return nil, nil
+	}
+	cloned, err := clone(e)
+	if err != nil {
+		return nil, err
+	}
+	return cloned.(Expression), nil
+}
+
+func cloneStatements(stmts []Statement) ([]Statement, error) {
+	if stmts == nil {
+		return nil, nil
+	}
+	cloned := make([]Statement, len(stmts))
+	for i, stmt := range stmts {
+		c, err := clone(stmt)
+		if err != nil {
+			return nil, err
+		}
+		cloned[i] = c.(Statement)
+	}
+	return cloned, nil
+}
+
+// cloneParameters copies the slice; Parameter holds only strings, which
+// are immutable, so the elements themselves need no deep copy.
+func cloneParameters(params []Parameter) []Parameter {
+	if params == nil {
+		return nil
+	}
+	cloned := make([]Parameter, len(params))
+	copy(cloned, params)
+	return cloned
+}
+
+func cloneVariableDeclaration(decl *VariableDeclaration) (*VariableDeclaration, error) {
+	init, err := cloneExpression(decl.Initializer)
+	if err != nil {
+		return nil, err
+	}
+	return &VariableDeclaration{
+		BaseNode:    decl.BaseNode,
+		Identifier:  decl.Identifier,
+		DataType:    decl.DataType,
+		Initializer: init,
+		Mutable:     decl.Mutable,
+	}, nil
+}
+
+func cloneFunctionDeclaration(fn *FunctionDeclaration) (*FunctionDeclaration, error) {
+	body, err := cloneStatements(fn.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &FunctionDeclaration{
+		BaseNode:   fn.BaseNode,
+		Name:       fn.Name,
+		Parameters: cloneParameters(fn.Parameters),
+		ReturnType: fn.ReturnType,
+		Body:       body,
+	}, nil
+}
+
+// cloneLiteral shares the underlying Value: every type a Literal can
+// hold (int, float64, bool, string) is immutable in Go, so there is
+// nothing for a deep copy to protect against.
+func cloneLiteral(lit *Literal) *Literal {
+	return &Literal{BaseNode: lit.BaseNode, Value: lit.Value}
+}
+
+func cloneIdentifier(ident *Identifier) *Identifier {
+	return &Identifier{BaseNode: ident.BaseNode, Name: ident.Name}
+}
+
+func cloneBinaryExpression(bin *BinaryExpression) (*BinaryExpression, error) {
+	left, err := cloneExpression(bin.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := cloneExpression(bin.Right)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryExpression{
+		BaseNode: bin.BaseNode,
+		Operator: bin.Operator,
+		Left:     left,
+		Right:    right,
+	}, nil
+}
+
+func cloneImplicitCast(ic *ImplicitCast) (*ImplicitCast, error) {
+	expr, err := cloneExpression(ic.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return &ImplicitCast{BaseNode: ic.BaseNode, Expr: expr, TargetType: ic.TargetType}, nil
+}
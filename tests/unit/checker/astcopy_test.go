@@ -0,0 +1,102 @@
+package main
+
+import "testing"
+
+func TestCloneVariableDeclarationIsIndependent(t *testing.T) {
+	original := &VariableDeclaration{
+		BaseNode:   BaseNode{Type: VariableDeclarationNode, Line: 1, Col: 1},
+		Identifier: "x",
+		DataType:   "int",
+		Initializer: &Literal{
+			BaseNode: BaseNode{Type: LiteralNode, Line: 1, Col: 5},
+			Value:    42,
+		},
+		Mutable: true,
+	}
+
+	cloned := Clone(original)
+	cloned.Identifier = "y"
+	cloned.Initializer.(*Literal).Value = 7
+
+	if original.Identifier != "x" {
+		t.Errorf("expected original.Identifier to stay %q, got %q", "x", original.Identifier)
+	}
+	if original.Initializer.(*Literal).Value != 42 {
+		t.Errorf("expected original initializer to stay 42, got %v", original.Initializer.(*Literal).Value)
+	}
+}
+
+func TestCloneFunctionDeclarationDeepCopiesBodyAndParameters(t *testing.T) {
+	original := &FunctionDeclaration{
+		BaseNode:   BaseNode{Type: FunctionDeclarationNode, Line: 1, Col: 1},
+		Name:       "add",
+		Parameters: []Parameter{{Name: "a", DataType: "int"}, {Name: "b", DataType: "int"}},
+		ReturnType: "int",
+		Body: []Statement{
+			&VariableDeclaration{
+				BaseNode:    BaseNode{Type: VariableDeclarationNode, Line: 2, Col: 1},
+				Identifier:  "sum",
+				DataType:    "int",
+				Initializer: &Literal{BaseNode: BaseNode{Type: LiteralNode, Line: 2, Col: 10}, Value: 0},
+				Mutable:     true,
+			},
+		},
+	}
+
+	cloned := Clone(original)
+	cloned.Parameters[0].Name = "x"
+	cloned.Body[0].(*VariableDeclaration).Identifier = "total"
+
+	if original.Parameters[0].Name != "a" {
+		t.Errorf("expected original parameter name to stay %q, got %q", "a", original.Parameters[0].Name)
+	}
+	if original.Body[0].(*VariableDeclaration).Identifier != "sum" {
+		t.Errorf("expected original body statement to stay %q, got %q", "sum", original.Body[0].(*VariableDeclaration).Identifier)
+	}
+}
+
+func TestCloneBinaryExpressionWithImplicitCast(t *testing.T) {
+	original := &BinaryExpression{
+		BaseNode: BaseNode{Type: BinaryExpressionNode, Line: 1, Col: 1},
+		Operator: "+",
+		Left:     &Literal{BaseNode: BaseNode{Type: LiteralNode, Line: 1, Col: 1}, Value: 5.5},
+		Right: &ImplicitCast{
+			BaseNode:   BaseNode{Type: ImplicitCastNode, Line: 1, Col: 3},
+			Expr:       &Literal{BaseNode: BaseNode{Type: LiteralNode, Line: 1, Col: 3}, Value: 3},
+			TargetType: FloatType,
+		},
+	}
+
+	cloned := Clone(original)
+	clonedCast := cloned.Right.(*ImplicitCast)
+	clonedCast.Expr.(*Literal).Value = 99
+
+	originalCast := original.Right.(*ImplicitCast)
+	if originalCast.Expr.(*Literal).Value != 3 {
+		t.Errorf("expected original cast operand to stay 3, got %v", originalCast.Expr.(*Literal).Value)
+	}
+	if clonedCast == originalCast {
+		t.Error("expected clone to produce a distinct ImplicitCast node")
+	}
+}
+
+// TestCloneTypedNilPointerDoesNotPanic is a regression test for a nil
+// typed pointer (as opposed to a nil interface) reaching clone(): it
+// used to be dispatched straight into e.g. cloneVariableDeclaration,
+// which dereferenced it and panicked.
+func TestCloneTypedNilPointerDoesNotPanic(t *testing.T) {
+	var nilDecl *VariableDeclaration
+	if got := Clone(nilDecl); got != nil {
+		t.Errorf("expected Clone of a typed-nil *VariableDeclaration to return nil, got %v", got)
+	}
+
+	var nilFn *FunctionDeclaration
+	if got := Clone(nilFn); got != nil {
+		t.Errorf("expected Clone of a typed-nil *FunctionDeclaration to return nil, got %v", got)
+	}
+
+	var nilBin *BinaryExpression
+	if got := Clone(nilBin); got != nil {
+		t.Errorf("expected Clone of a typed-nil *BinaryExpression to return nil, got %v", got)
+	}
+}
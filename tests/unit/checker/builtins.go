@@ -0,0 +1,35 @@
+package main
+
+import "fmt"
+
+// registerBuiltins defines the small set of built-in functions every
+// interpreter environment starts with.
+func registerBuiltins(env *Environment) {
+	env.Define("len", newFunctionValue(&BuiltinFunction{
+		name:  "len",
+		arity: 1,
+		fn: func(args []Value) (Value, error) {
+			if args[0].Kind != StringValue {
+				return Value{}, fmt.Errorf("len expects a string, got %s", args[0].Type())
+			}
+			return newIntValue(len(args[0].strVal)), nil
+		},
+	}))
+
+	env.Define("println", newFunctionValue(&BuiltinFunction{
+		name:  "println",
+		arity: 1,
+		fn: func(args []Value) (Value, error) {
+			fmt.Println(args[0].String())
+			return theVoidValue, nil
+		},
+	}))
+
+	env.Define("panic", newFunctionValue(&BuiltinFunction{
+		name:  "panic",
+		arity: 1,
+		fn: func(args []Value) (Value, error) {
+			return Value{}, fmt.Errorf("panic: %s", args[0].String())
+		},
+	}))
+}
@@ -0,0 +1,68 @@
+package main
+
+import "fmt"
+
+// Callable is anything that can appear on the left of a call: a
+// user-defined function or a built-in.
+type Callable interface {
+	Arity() int
+	Call(args []Value) (Value, error)
+}
+
+// UserFunction is a Callable backed by a FunctionDeclaration, closing
+// over the environment it was declared in.
+type UserFunction struct {
+	decl    *FunctionDeclaration
+	closure *Environment
+	interp  *Interpreter
+}
+
+func (f *UserFunction) Arity() int { return len(f.decl.Parameters) }
+
+func (f *UserFunction) Call(args []Value) (Value, error) {
+	if len(args) != f.Arity() {
+		return Value{}, fmt.Errorf("%s expects %d argument(s), got %d", f.decl.Name, f.Arity(), len(args))
+	}
+
+	callEnv := NewEnvironment(f.closure)
+	for i, param := range f.decl.Parameters {
+		callEnv.Define(param.Name, args[i])
+	}
+
+	previous := f.interp.current
+	f.interp.current = callEnv
+	defer func() { f.interp.current = previous }()
+
+	for _, stmt := range f.decl.Body {
+		if _, err := f.interp.Eval(stmt); err != nil {
+			return Value{}, err
+		}
+	}
+	return theVoidValue, nil
+}
+
+// BuiltinFunction is a Callable implemented in Go, registered into the
+// top-level environment before any user code runs.
+type BuiltinFunction struct {
+	name  string
+	arity int
+	fn    func(args []Value) (Value, error)
+}
+
+func (b *BuiltinFunction) Arity() int { return b.arity }
+
+func (b *BuiltinFunction) Call(args []Value) (Value, error) {
+	if len(args) != b.arity {
+		return Value{}, fmt.Errorf("%s expects %d argument(s), got %d", b.name, b.arity, len(args))
+	}
+	return b.fn(args)
+}
+
+// call invokes callee with args, producing a coherent runtime error if
+// callee is not actually callable.
+func call(callee Value, args []Value) (Value, error) {
+	if callee.Kind != FunctionValue {
+		return Value{}, fmt.Errorf("cannot call a value of type %s", callee.Type())
+	}
+	return callee.fnVal.Call(args)
+}
@@ -0,0 +1,265 @@
+package main
+
+import "fmt"
+
+// TypeChecker walks a Fax AST and reports type errors. It accumulates
+// every error it finds in a single pass rather than stopping at the
+// first one, so callers can surface many problems at once; Check and
+// checkExpression also return the most recent error for callers that
+// only care whether the node they just checked was valid.
+type TypeChecker struct {
+	current *Scope
+	errors  []CheckError
+}
+
+// NewTypeChecker returns a checker with an empty top-level scope.
+func NewTypeChecker() *TypeChecker {
+	return &TypeChecker{current: NewScope(nil)}
+}
+
+// EnterScope pushes a new scope nested inside the current one, used
+// when descending into a function body, block, or loop body.
+func (c *TypeChecker) EnterScope() {
+	c.current = NewScope(c.current)
+}
+
+// ExitScope pops back to the enclosing scope. It is a no-op at the
+// top-level scope.
+func (c *TypeChecker) ExitScope() {
+	if c.current.Parent != nil {
+		c.current = c.current.Parent
+	}
+}
+
+// Errors returns every error accumulated across all calls to Check.
+func (c *TypeChecker) Errors() []CheckError {
+	return c.errors
+}
+
+// fail records a CheckError and returns it so call sites can both
+// accumulate and react to the failure immediately.
+func (c *TypeChecker) fail(code ErrorCode, msg string, node Node) *CheckError {
+	line, col := node.Position()
+	c.errors = append(c.errors, CheckError{Code: code, Msg: msg, Line: line, Col: col, Node: node})
+	return &c.errors[len(c.errors)-1]
+}
+
+// define declares name in the current scope with full symbol metadata,
+// translating a redeclaration into a coded DuplicateDeclaration error.
+func (c *TypeChecker) define(name string, t Type, mutable bool, kind SymbolKind, node Node) error {
+	line, col := node.Position()
+	if err := c.current.define(Symbol{Name: name, Type: t, Mutable: mutable, Kind: kind, Line: line, Col: col}); err != nil {
+		return c.fail(DuplicateDeclaration, err.Error(), node)
+	}
+	return nil
+}
+
+// Check type-checks a single top-level node.
+func (c *TypeChecker) Check(node Node) error {
+	switch n := node.(type) {
+	case *VariableDeclaration:
+		return c.checkVariableDeclaration(n)
+	case *FunctionDeclaration:
+		return c.checkFunctionDeclaration(n)
+	default:
+		if expr, ok := node.(Expression); ok {
+			_, err := c.checkExpression(expr)
+			return err
+		}
+		return nil
+	}
+}
+
+func (c *TypeChecker) checkVariableDeclaration(decl *VariableDeclaration) error {
+	if _, exists := c.current.LookupLocal(decl.Identifier); exists {
+		return c.fail(DuplicateDeclaration, fmt.Sprintf("%q is already declared", decl.Identifier), decl)
+	}
+
+	declType := typeFromName(decl.DataType)
+	if decl.DataType == "" {
+		if decl.Initializer == nil {
+			return c.fail(MissingTypeAndInitializer, fmt.Sprintf("variable %q needs a type annotation or an initializer", decl.Identifier), decl)
+		}
+		initType, err := c.checkExpression(decl.Initializer)
+		if err != nil {
+			return err
+		}
+		declType = initType
+	} else if decl.Initializer != nil {
+		initType, err := c.checkExpression(decl.Initializer)
+		if err != nil {
+			return err
+		}
+		if initType != declType {
+			return c.fail(TypeMismatch, fmt.Sprintf("cannot assign %s to variable %q of type %s", initType, decl.Identifier, declType), decl)
+		}
+	}
+
+	return c.define(decl.Identifier, declType, decl.Mutable, VarSymbol, decl)
+}
+
+func (c *TypeChecker) checkFunctionDeclaration(fn *FunctionDeclaration) error {
+	if _, exists := c.current.LookupLocal(fn.Name); exists {
+		return c.fail(DuplicateDeclaration, fmt.Sprintf("%q is already declared", fn.Name), fn)
+	}
+	if err := c.define(fn.Name, typeFromName(fn.ReturnType), false, FuncSymbol, fn); err != nil {
+		return err
+	}
+
+	c.EnterScope()
+	defer c.ExitScope()
+
+	var firstErr error
+	for _, param := range fn.Parameters {
+		if err := c.define(param.Name, typeFromName(param.DataType), true, ParamSymbol, fn); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	// Keep checking every statement in the body even once one has
+	// failed, so a single Check call surfaces every independent
+	// problem instead of stopping at the first.
+	for _, stmt := range fn.Body {
+		if err := c.Check(stmt); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// checkExpression type-checks an expression and returns its resulting
+// Type.
+func (c *TypeChecker) checkExpression(expr Expression) (Type, error) {
+	switch e := expr.(type) {
+	case *Literal:
+		return c.checkLiteral(e)
+	case *Identifier:
+		sym, ok := c.current.Lookup(e.Name)
+		if !ok {
+			return UnknownType, c.fail(UndeclaredIdentifier, fmt.Sprintf("%q is not declared", e.Name), e)
+		}
+		return sym.Type, nil
+	case *BinaryExpression:
+		return c.checkBinaryExpression(e)
+	case *ImplicitCast:
+		return e.TargetType, nil
+	default:
+		return UnknownType, nil
+	}
+}
+
+func (c *TypeChecker) checkLiteral(lit *Literal) (Type, error) {
+	switch lit.Value.(type) {
+	case int:
+		return IntType, nil
+	case float64:
+		return FloatType, nil
+	case bool:
+		return BoolType, nil
+	case string:
+		return StringType, nil
+	default:
+		return UnknownType, c.fail(InvalidOperandForOperator, fmt.Sprintf("literal has unsupported value type %T", lit.Value), lit)
+	}
+}
+
+// binaryOpKind groups operators that share the same type-checking
+// rules.
+type binaryOpKind int
+
+const (
+	arithmeticOp binaryOpKind = iota
+	comparisonOp
+	logicalOp
+)
+
+// binaryOperators is the operator dispatch table: every binary
+// operator the checker understands, keyed by its literal spelling.
+var binaryOperators = map[string]binaryOpKind{
+	"+": arithmeticOp, "-": arithmeticOp, "*": arithmeticOp, "/": arithmeticOp,
+	"==": comparisonOp, "!=": comparisonOp, "<": comparisonOp, "<=": comparisonOp, ">": comparisonOp, ">=": comparisonOp,
+	"&&": logicalOp, "||": logicalOp,
+}
+
+// unifyNumeric reports the common numeric type two operand types
+// promote to, if any. int+int stays int; mixing int and float
+// promotes to float. Anything else is not a numeric pair.
+func unifyNumeric(l, r Type) (Type, bool) {
+	if l == IntType && r == IntType {
+		return IntType, true
+	}
+	if (l == IntType || l == FloatType) && (r == IntType || r == FloatType) {
+		return FloatType, true
+	}
+	return UnknownType, false
+}
+
+func (c *TypeChecker) checkBinaryExpression(bin *BinaryExpression) (Type, error) {
+	// Check both operands even if the left one fails, so an
+	// independent problem on the right is still reported instead of
+	// being masked by the early return.
+	leftType, leftErr := c.checkExpression(bin.Left)
+	rightType, rightErr := c.checkExpression(bin.Right)
+	if leftErr != nil {
+		return UnknownType, leftErr
+	}
+	if rightErr != nil {
+		return UnknownType, rightErr
+	}
+
+	kind, ok := binaryOperators[bin.Operator]
+	if !ok {
+		return UnknownType, c.fail(InvalidOperandForOperator, fmt.Sprintf("unsupported operator %q", bin.Operator), bin)
+	}
+
+	switch kind {
+	case arithmeticOp:
+		return c.checkArithmetic(bin, leftType, rightType)
+	case comparisonOp:
+		return c.checkComparison(bin, leftType, rightType)
+	default:
+		return c.checkLogical(bin, leftType, rightType)
+	}
+}
+
+func (c *TypeChecker) checkArithmetic(bin *BinaryExpression, leftType, rightType Type) (Type, error) {
+	if bin.Operator == "+" && leftType == StringType && rightType == StringType {
+		return StringType, nil
+	}
+	if result, ok := unifyNumeric(leftType, rightType); ok {
+		c.promote(bin, leftType, rightType, result)
+		return result, nil
+	}
+	return UnknownType, c.fail(InvalidOperandForOperator, fmt.Sprintf("operator %q does not support operands of type %s and %s", bin.Operator, leftType, rightType), bin)
+}
+
+func (c *TypeChecker) checkComparison(bin *BinaryExpression, leftType, rightType Type) (Type, error) {
+	if leftType == rightType {
+		return BoolType, nil
+	}
+	if result, ok := unifyNumeric(leftType, rightType); ok {
+		c.promote(bin, leftType, rightType, result)
+		return BoolType, nil
+	}
+	return UnknownType, c.fail(TypeMismatch, fmt.Sprintf("cannot compare %s with %s", leftType, rightType), bin)
+}
+
+func (c *TypeChecker) checkLogical(bin *BinaryExpression, leftType, rightType Type) (Type, error) {
+	if leftType != BoolType || rightType != BoolType {
+		return UnknownType, c.fail(InvalidOperandForOperator, fmt.Sprintf("operator %q requires bool operands, got %s and %s", bin.Operator, leftType, rightType), bin)
+	}
+	return BoolType, nil
+}
+
+// promote inserts an ImplicitCast on whichever side(s) of bin do not
+// already have type target, so a later evaluator sees the coercion
+// instead of re-deriving it.
+func (c *TypeChecker) promote(bin *BinaryExpression, leftType, rightType, target Type) {
+	if leftType != target {
+		line, col := bin.Left.Position()
+		bin.Left = &ImplicitCast{BaseNode: BaseNode{Type: ImplicitCastNode, Line: line, Col: col}, Expr: bin.Left, TargetType: target}
+	}
+	if rightType != target {
+		line, col := bin.Right.Position()
+		bin.Right = &ImplicitCast{BaseNode: BaseNode{Type: ImplicitCastNode, Line: line, Col: col}, Expr: bin.Right, TargetType: target}
+	}
+}
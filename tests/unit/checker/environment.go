@@ -0,0 +1,32 @@
+package main
+
+// Environment is the runtime counterpart of Scope: a chain of variable
+// bindings that mirrors the lexical nesting the type checker already
+// validated.
+type Environment struct {
+	Parent *Environment
+	values map[string]Value
+}
+
+// NewEnvironment creates an environment nested inside parent. Pass a
+// nil parent for the top-level environment.
+func NewEnvironment(parent *Environment) *Environment {
+	return &Environment{Parent: parent, values: make(map[string]Value)}
+}
+
+// Define binds name to v in this environment, overwriting any existing
+// binding for name in this exact environment.
+func (e *Environment) Define(name string, v Value) {
+	e.values[name] = v
+}
+
+// Get resolves name in this environment or, failing that, any
+// enclosing environment.
+func (e *Environment) Get(name string) (Value, bool) {
+	for env := e; env != nil; env = env.Parent {
+		if v, ok := env.values[name]; ok {
+			return v, true
+		}
+	}
+	return Value{}, false
+}
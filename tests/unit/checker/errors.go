@@ -0,0 +1,63 @@
+package main
+
+import "fmt"
+
+// ErrorCode identifies a specific category of type-checking failure so
+// that tooling (LSPs, linters, IDE integrations) can react to it without
+// parsing error strings.
+type ErrorCode int
+
+const (
+	// UndeclaredIdentifier is reported when an identifier is referenced
+	// before it has been declared in any visible scope.
+	UndeclaredIdentifier ErrorCode = iota
+	// MissingTypeAndInitializer is reported when a variable declaration
+	// has neither a type annotation nor an initializer to infer one from.
+	MissingTypeAndInitializer
+	// TypeMismatch is reported when two types are used together in a
+	// position that requires them to agree (assignment, comparison, ...).
+	TypeMismatch
+	// InvalidOperandForOperator is reported when an operator is applied
+	// to operand types it does not support.
+	InvalidOperandForOperator
+	// DuplicateDeclaration is reported when a name is declared twice in
+	// the same scope.
+	DuplicateDeclaration
+	// AssignmentToImmutable will be reported when an assignment targets
+	// a variable that was declared without the mutable flag. It is not
+	// produced yet: there is no assignment statement in the AST for the
+	// checker to inspect. Wire it up once chunk0-2's scope work grows an
+	// assignment node.
+)
+
+func (c ErrorCode) String() string {
+	switch c {
+	case UndeclaredIdentifier:
+		return "UndeclaredIdentifier"
+	case MissingTypeAndInitializer:
+		return "MissingTypeAndInitializer"
+	case TypeMismatch:
+		return "TypeMismatch"
+	case InvalidOperandForOperator:
+		return "InvalidOperandForOperator"
+	case DuplicateDeclaration:
+		return "DuplicateDeclaration"
+	default:
+		return "UnknownError"
+	}
+}
+
+// CheckError is a single type-checking failure, carrying enough
+// structure for callers to key off the failure kind rather than the
+// message text.
+type CheckError struct {
+	Code ErrorCode
+	Msg  string
+	Line int
+	Col  int
+	Node Node
+}
+
+func (e *CheckError) Error() string {
+	return fmt.Sprintf("%d:%d: %s: %s", e.Line, e.Col, e.Code, e.Msg)
+}
@@ -0,0 +1,217 @@
+package main
+
+import "fmt"
+
+// Interpreter evaluates an already type-checked Fax AST, dispatching on
+// the same node kinds TypeChecker does.
+type Interpreter struct {
+	current *Environment
+}
+
+// NewInterpreter returns an interpreter whose top-level environment has
+// the standard built-ins already defined.
+func NewInterpreter() *Interpreter {
+	env := NewEnvironment(nil)
+	registerBuiltins(env)
+	return &Interpreter{current: env}
+}
+
+// EnterScope pushes a new environment nested inside the current one.
+func (in *Interpreter) EnterScope() {
+	in.current = NewEnvironment(in.current)
+}
+
+// ExitScope pops back to the enclosing environment. It is a no-op at
+// the top-level environment.
+func (in *Interpreter) ExitScope() {
+	if in.current.Parent != nil {
+		in.current = in.current.Parent
+	}
+}
+
+// Eval evaluates a single node and returns the Value it produces.
+// Declarations evaluate to VoidValue for their side effect of binding a
+// name in the current environment.
+func (in *Interpreter) Eval(node Node) (Value, error) {
+	switch n := node.(type) {
+	case *Literal:
+		return evalLiteral(n)
+	case *Identifier:
+		v, ok := in.current.Get(n.Name)
+		if !ok {
+			return Value{}, fmt.Errorf("%q is not declared", n.Name)
+		}
+		return v, nil
+	case *BinaryExpression:
+		return in.evalBinaryExpression(n)
+	case *ImplicitCast:
+		return in.evalImplicitCast(n)
+	case *VariableDeclaration:
+		return in.evalVariableDeclaration(n)
+	case *FunctionDeclaration:
+		return in.evalFunctionDeclaration(n)
+	default:
+		return Value{}, fmt.Errorf("cannot evaluate node of type %T", node)
+	}
+}
+
+func evalLiteral(lit *Literal) (Value, error) {
+	switch v := lit.Value.(type) {
+	case int:
+		return newIntValue(v), nil
+	case float64:
+		return newFloatValue(v), nil
+	case bool:
+		return newBoolValue(v), nil
+	case string:
+		return newStringValue(v), nil
+	default:
+		return Value{}, fmt.Errorf("literal has unsupported value type %T", lit.Value)
+	}
+}
+
+// evalImplicitCast evaluates the wrapped expression and coerces it to
+// the type the checker decided it should promote to.
+func (in *Interpreter) evalImplicitCast(ic *ImplicitCast) (Value, error) {
+	v, err := in.Eval(ic.Expr)
+	if err != nil {
+		return Value{}, err
+	}
+	if ic.TargetType == FloatType && v.Kind == IntValue {
+		return newFloatValue(float64(v.intVal)), nil
+	}
+	return v, nil
+}
+
+func (in *Interpreter) evalVariableDeclaration(decl *VariableDeclaration) (Value, error) {
+	value := theVoidValue
+	if decl.Initializer != nil {
+		v, err := in.Eval(decl.Initializer)
+		if err != nil {
+			return Value{}, err
+		}
+		value = v
+	}
+	in.current.Define(decl.Identifier, value)
+	return theVoidValue, nil
+}
+
+func (in *Interpreter) evalFunctionDeclaration(fn *FunctionDeclaration) (Value, error) {
+	in.current.Define(fn.Name, newFunctionValue(&UserFunction{decl: fn, closure: in.current, interp: in}))
+	return theVoidValue, nil
+}
+
+func (in *Interpreter) evalBinaryExpression(bin *BinaryExpression) (Value, error) {
+	left, err := in.Eval(bin.Left)
+	if err != nil {
+		return Value{}, err
+	}
+	right, err := in.Eval(bin.Right)
+	if err != nil {
+		return Value{}, err
+	}
+
+	switch bin.Operator {
+	case "+":
+		if left.Kind == StringValue && right.Kind == StringValue {
+			return newStringValue(left.strVal + right.strVal), nil
+		}
+		return evalNumeric(bin.Operator, left, right)
+	case "-", "*", "/":
+		return evalNumeric(bin.Operator, left, right)
+	case "==", "!=", "<", "<=", ">", ">=":
+		return evalComparison(bin.Operator, left, right)
+	case "&&", "||":
+		return evalLogical(bin.Operator, left, right)
+	default:
+		return Value{}, fmt.Errorf("unsupported operator %q", bin.Operator)
+	}
+}
+
+func evalNumeric(op string, left, right Value) (Value, error) {
+	if left.Kind == FloatValue || right.Kind == FloatValue {
+		l, r := asFloat(left), asFloat(right)
+		switch op {
+		case "+":
+			return newFloatValue(l + r), nil
+		case "-":
+			return newFloatValue(l - r), nil
+		case "*":
+			return newFloatValue(l * r), nil
+		case "/":
+			if r == 0 {
+				return Value{}, fmt.Errorf("division by zero")
+			}
+			return newFloatValue(l / r), nil
+		}
+	}
+
+	l, r := left.intVal, right.intVal
+	switch op {
+	case "+":
+		return newIntValue(l + r), nil
+	case "-":
+		return newIntValue(l - r), nil
+	case "*":
+		return newIntValue(l * r), nil
+	case "/":
+		if r == 0 {
+			return Value{}, fmt.Errorf("division by zero")
+		}
+		return newIntValue(l / r), nil
+	}
+	return Value{}, fmt.Errorf("unsupported operator %q", op)
+}
+
+func asFloat(v Value) float64 {
+	if v.Kind == FloatValue {
+		return v.floatVal
+	}
+	return float64(v.intVal)
+}
+
+func evalComparison(op string, left, right Value) (Value, error) {
+	switch op {
+	case "==":
+		return newBoolValue(valuesEqual(left, right)), nil
+	case "!=":
+		return newBoolValue(!valuesEqual(left, right)), nil
+	case "<", "<=", ">", ">=":
+		l, r := asFloat(left), asFloat(right)
+		switch op {
+		case "<":
+			return newBoolValue(l < r), nil
+		case "<=":
+			return newBoolValue(l <= r), nil
+		case ">":
+			return newBoolValue(l > r), nil
+		default:
+			return newBoolValue(l >= r), nil
+		}
+	default:
+		return Value{}, fmt.Errorf("unsupported operator %q", op)
+	}
+}
+
+func valuesEqual(left, right Value) bool {
+	switch left.Kind {
+	case IntValue, FloatValue:
+		return asFloat(left) == asFloat(right)
+	case BoolValue:
+		return left.boolVal == right.boolVal
+	case StringValue:
+		return left.strVal == right.strVal
+	default:
+		return left.Kind == right.Kind
+	}
+}
+
+func evalLogical(op string, left, right Value) (Value, error) {
+	if left.Kind != BoolValue || right.Kind != BoolValue {
+		return Value{}, fmt.Errorf("operator %q requires bool operands", op)
+	}
+	if op == "&&" {
+		return newBoolValue(left.boolVal && right.boolVal), nil
+	}
+	return newBoolValue(left.boolVal || right.boolVal), nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEvalLiteral(t *testing.T) {
+	interp := NewInterpreter()
+
+	lit := &Literal{BaseNode: BaseNode{Type: LiteralNode, Line: 1, Col: 1}, Value: 42}
+	v, err := interp.Eval(lit)
+	if err != nil {
+		t.Fatalf("unexpected error evaluating literal: %v", err)
+	}
+	if v.Kind != IntValue || v.intVal != 42 {
+		t.Errorf("expected int value 42, got %v", v)
+	}
+}
+
+func TestEvalVariableDeclarationAndIdentifier(t *testing.T) {
+	interp := NewInterpreter()
+
+	decl := createTestVariableDeclaration("x", "int", true)
+	if _, err := interp.Eval(decl); err != nil {
+		t.Fatalf("unexpected error evaluating declaration: %v", err)
+	}
+
+	ident := &Identifier{BaseNode: BaseNode{Type: IdentifierNode, Line: 1, Col: 1}, Name: "x"}
+	v, err := interp.Eval(ident)
+	if err != nil {
+		t.Fatalf("unexpected error evaluating identifier: %v", err)
+	}
+	if v.Kind != IntValue || v.intVal != 42 {
+		t.Errorf("expected x to hold int 42, got %v", v)
+	}
+
+	unknown := &Identifier{BaseNode: BaseNode{Type: IdentifierNode, Line: 1, Col: 1}, Name: "missing"}
+	if _, err := interp.Eval(unknown); err == nil {
+		t.Error("expected error evaluating an undeclared identifier, got none")
+	}
+}
+
+func TestEvalBinaryExpressionArithmeticAndDivisionByZero(t *testing.T) {
+	interp := NewInterpreter()
+
+	five := &Literal{BaseNode: BaseNode{Type: LiteralNode, Line: 1, Col: 1}, Value: 5}
+	three := &Literal{BaseNode: BaseNode{Type: LiteralNode, Line: 1, Col: 3}, Value: 3}
+	zero := &Literal{BaseNode: BaseNode{Type: LiteralNode, Line: 1, Col: 3}, Value: 0}
+
+	sum := &BinaryExpression{BaseNode: BaseNode{Type: BinaryExpressionNode, Line: 1, Col: 2}, Operator: "+", Left: five, Right: three}
+	v, err := interp.Eval(sum)
+	if err != nil {
+		t.Fatalf("unexpected error evaluating sum: %v", err)
+	}
+	if v.Kind != IntValue || v.intVal != 8 {
+		t.Errorf("expected 5 + 3 == 8, got %v", v)
+	}
+
+	divByZero := &BinaryExpression{BaseNode: BaseNode{Type: BinaryExpressionNode, Line: 1, Col: 2}, Operator: "/", Left: five, Right: zero}
+	if _, err := interp.Eval(divByZero); err == nil {
+		t.Error("expected division by zero to error, got none")
+	}
+}
+
+func TestEvalFunctionCall(t *testing.T) {
+	interp := NewInterpreter()
+
+	fnDecl := createTestFunctionDeclaration("identity", "int")
+	fnDecl.Parameters = []Parameter{{Name: "n", DataType: "int"}}
+	if _, err := interp.Eval(fnDecl); err != nil {
+		t.Fatalf("unexpected error declaring function: %v", err)
+	}
+
+	fnValue, ok := interp.current.Get("identity")
+	if !ok {
+		t.Fatal("expected identity to be bound in the environment")
+	}
+
+	if _, err := call(fnValue, []Value{newIntValue(7)}); err != nil {
+		t.Fatalf("unexpected error calling identity: %v", err)
+	}
+
+	if _, err := call(fnValue, nil); err == nil {
+		t.Error("expected calling identity with the wrong arity to error, got none")
+	}
+
+	if _, err := call(newIntValue(1), []Value{newIntValue(7)}); err == nil {
+		t.Error("expected calling a non-callable value to error, got none")
+	}
+}
+
+func TestBuiltinFunctions(t *testing.T) {
+	interp := NewInterpreter()
+
+	lenFn, ok := interp.current.Get("len")
+	if !ok {
+		t.Fatal("expected len to be a built-in")
+	}
+	v, err := call(lenFn, []Value{newStringValue("hello")})
+	if err != nil {
+		t.Fatalf("unexpected error calling len: %v", err)
+	}
+	if v.Kind != IntValue || v.intVal != 5 {
+		t.Errorf("expected len(\"hello\") == 5, got %v", v)
+	}
+
+	panicFn, ok := interp.current.Get("panic")
+	if !ok {
+		t.Fatal("expected panic to be a built-in")
+	}
+	_, err = call(panicFn, []Value{newStringValue("boom")})
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected panic to surface its message as an error, got: %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import "fmt"
+
+// SymbolKind distinguishes the different things a name in scope can
+// refer to.
+type SymbolKind int
+
+const (
+	VarSymbol SymbolKind = iota
+	ParamSymbol
+	FuncSymbol
+)
+
+// Symbol is everything the checker knows about a declared name: its
+// type, whether it can be reassigned, what kind of declaration
+// introduced it, and where that declaration lives.
+type Symbol struct {
+	Name    string
+	Type    Type
+	Mutable bool
+	Kind    SymbolKind
+	Line    int
+	Col     int
+}
+
+// Scope is a single lexical block's symbol table, chained to its
+// enclosing scope so lookups can walk outward to find a name.
+type Scope struct {
+	Parent  *Scope
+	symbols map[string]Symbol
+}
+
+// NewScope creates a scope nested inside parent. Pass a nil parent for
+// the top-level scope.
+func NewScope(parent *Scope) *Scope {
+	return &Scope{Parent: parent, symbols: make(map[string]Symbol)}
+}
+
+// Define introduces name into this scope. It fails if name is already
+// declared in this exact scope; shadowing a name from an enclosing
+// scope is allowed.
+func (s *Scope) Define(name string, t Type, mutable bool) error {
+	return s.define(Symbol{Name: name, Type: t, Mutable: mutable, Kind: VarSymbol})
+}
+
+func (s *Scope) define(sym Symbol) error {
+	if _, exists := s.symbols[sym.Name]; exists {
+		return fmt.Errorf("%q is already declared in this scope", sym.Name)
+	}
+	s.symbols[sym.Name] = sym
+	return nil
+}
+
+// Lookup resolves name in this scope or, failing that, any enclosing
+// scope.
+func (s *Scope) Lookup(name string) (Symbol, bool) {
+	for sc := s; sc != nil; sc = sc.Parent {
+		if sym, ok := sc.symbols[name]; ok {
+			return sym, true
+		}
+	}
+	return Symbol{}, false
+}
+
+// LookupLocal resolves name in this scope only, without consulting any
+// enclosing scope.
+func (s *Scope) LookupLocal(name string) (Symbol, bool) {
+	sym, ok := s.symbols[name]
+	return sym, ok
+}
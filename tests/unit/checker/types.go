@@ -0,0 +1,49 @@
+package main
+
+// Type is the set of types the checker reasons about.
+type Type int
+
+const (
+	UnknownType Type = iota
+	IntType
+	FloatType
+	BoolType
+	StringType
+	VoidType
+)
+
+func (t Type) String() string {
+	switch t {
+	case IntType:
+		return "int"
+	case FloatType:
+		return "float"
+	case BoolType:
+		return "bool"
+	case StringType:
+		return "string"
+	case VoidType:
+		return "void"
+	default:
+		return "unknown"
+	}
+}
+
+// typeFromName maps a source-level type annotation to its Type. An
+// unrecognized or empty annotation maps to UnknownType.
+func typeFromName(name string) Type {
+	switch name {
+	case "int":
+		return IntType
+	case "float":
+		return FloatType
+	case "bool":
+		return BoolType
+	case "string":
+		return StringType
+	case "void":
+		return VoidType
+	default:
+		return UnknownType
+	}
+}
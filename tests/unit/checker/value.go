@@ -0,0 +1,67 @@
+package main
+
+import "fmt"
+
+// ValueKind identifies the concrete kind of value a Value holds.
+type ValueKind int
+
+const (
+	VoidValue ValueKind = iota
+	IntValue
+	FloatValue
+	BoolValue
+	StringValue
+	FunctionValue
+)
+
+// Value is the runtime counterpart of Type: every result the
+// interpreter produces is one of these.
+type Value struct {
+	Kind     ValueKind
+	intVal   int
+	floatVal float64
+	boolVal  bool
+	strVal   string
+	fnVal    Callable
+}
+
+func newIntValue(i int) Value           { return Value{Kind: IntValue, intVal: i} }
+func newFloatValue(f float64) Value     { return Value{Kind: FloatValue, floatVal: f} }
+func newBoolValue(b bool) Value         { return Value{Kind: BoolValue, boolVal: b} }
+func newStringValue(s string) Value     { return Value{Kind: StringValue, strVal: s} }
+func newFunctionValue(c Callable) Value { return Value{Kind: FunctionValue, fnVal: c} }
+
+var theVoidValue = Value{Kind: VoidValue}
+
+func (v Value) String() string {
+	switch v.Kind {
+	case IntValue:
+		return fmt.Sprintf("%d", v.intVal)
+	case FloatValue:
+		return fmt.Sprintf("%g", v.floatVal)
+	case BoolValue:
+		return fmt.Sprintf("%t", v.boolVal)
+	case StringValue:
+		return v.strVal
+	case FunctionValue:
+		return "<function>"
+	default:
+		return "<void>"
+	}
+}
+
+// Type returns the static Type that corresponds to this value's kind.
+func (v Value) Type() Type {
+	switch v.Kind {
+	case IntValue:
+		return IntType
+	case FloatValue:
+		return FloatType
+	case BoolValue:
+		return BoolType
+	case StringValue:
+		return StringType
+	default:
+		return VoidType
+	}
+}